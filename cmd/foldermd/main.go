@@ -0,0 +1,318 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bete7512/foldermd/pkg/ignore"
+	"github.com/bete7512/foldermd/pkg/meta"
+	"github.com/bete7512/foldermd/pkg/render"
+	"github.com/bete7512/foldermd/pkg/tree"
+)
+
+const (
+	version = "1.0.0"
+)
+
+type Config struct {
+	includeFiles   bool
+	includeContent bool
+	outputFile     string
+	ignorePatterns []string
+	maxDepth       int
+	showHidden     bool
+	targetDir      string
+	noGitignore    bool
+	format         string
+	templatePath   string
+	noMeta         bool
+	disabledMeta   map[string]bool
+	watch          bool
+	jobs           int
+}
+
+var config Config
+
+// metaToggles holds the per-detector --no-meta-<name> flags; merged
+// into config.disabledMeta once flags are parsed.
+var metaToggles = map[string]*bool{}
+var noMetaGit bool
+
+var rootCmd = &cobra.Command{
+	Use:   "foldermd [directory]",
+	Short: "Generate README from folder structure",
+	Long: `foldermd is a CLI tool that generates a README.md file from your current
+folder structure with optional file content inclusion.
+
+The tool creates a beautifully formatted README with:
+- Project structure tree visualization
+- Optional file content with syntax highlighting
+- Smart filtering of common ignore patterns
+- Customizable output options`,
+	Example: `  # Generate README for current directory
+  foldermd
+
+  # Generate with files included
+  foldermd --files
+
+  # Generate with file contents and custom output name
+  foldermd --content --output PROJECT.md
+
+  # Generate for specific directory with depth limit
+  foldermd /path/to/project --files --depth 3
+
+  # Custom ignore patterns
+  foldermd --files --ignore ".git,*.log,build,dist"`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := resolveConfig(cmd, args); err != nil {
+			return err
+		}
+		if config.watch {
+			return watchAndGenerate(config)
+		}
+		return generateReadme(config)
+	},
+}
+
+// resolveConfig fills in config from the parsed flags and positional
+// directory argument; it's shared by the root command and `watch` so
+// both validate and normalize the same way.
+func resolveConfig(cmd *cobra.Command, args []string) error {
+	// Set target directory
+	config.targetDir = "."
+	if len(args) > 0 {
+		config.targetDir = args[0]
+	}
+
+	// Validate target directory exists
+	if _, err := os.Stat(config.targetDir); os.IsNotExist(err) {
+		return fmt.Errorf("directory '%s' does not exist", config.targetDir)
+	}
+
+	// Parse ignore patterns
+	ignoreList, _ := cmd.Flags().GetString("ignore")
+	if ignoreList != "" {
+		config.ignorePatterns = strings.Split(ignoreList, ",")
+		// Trim whitespace from patterns
+		for i, pattern := range config.ignorePatterns {
+			config.ignorePatterns[i] = strings.TrimSpace(pattern)
+		}
+	}
+
+	// If content is requested, automatically include files
+	if config.includeContent {
+		config.includeFiles = true
+	}
+
+	if !render.IsFormat(config.format) {
+		return fmt.Errorf("unknown format %q (want %s)", config.format, strings.Join(render.Formats(), ", "))
+	}
+
+	config.disabledMeta = map[string]bool{}
+	for name, disabled := range metaToggles {
+		config.disabledMeta[name] = *disabled
+	}
+
+	return nil
+}
+
+var versionCmd = &cobra.Command{
+	Use:   "version",
+	Short: "Print the version number",
+	Long:  "Print the version number of foldermd",
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Printf("foldermd v%s\n", version)
+	},
+}
+
+var initCmd = &cobra.Command{
+	Use:   "init",
+	Short: "Initialize a sample .foldermd.ignore file",
+	Long:  "Create a .foldermd.ignore file in the current directory with common ignore patterns",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return createIgnoreFile()
+	},
+}
+
+func init() {
+	// Add subcommands
+	rootCmd.AddCommand(versionCmd)
+	rootCmd.AddCommand(initCmd)
+
+	// Global flags
+	rootCmd.PersistentFlags().BoolVarP(&config.includeFiles, "files", "f", false, "Include files in the tree structure")
+	rootCmd.PersistentFlags().BoolVarP(&config.includeContent, "content", "c", false, "Include file contents with syntax highlighting (implies --files)")
+	rootCmd.PersistentFlags().StringVarP(&config.outputFile, "output", "o", "README.md", "Output README file name")
+	rootCmd.PersistentFlags().StringP("ignore", "i", ".git,.DS_Store,node_modules,*.log", "Comma-separated patterns to ignore")
+	rootCmd.PersistentFlags().IntVarP(&config.maxDepth, "depth", "d", -1, "Maximum directory depth to traverse (-1 for unlimited)")
+	rootCmd.PersistentFlags().BoolVar(&config.showHidden, "hidden", false, "Include hidden files and directories")
+	rootCmd.PersistentFlags().BoolVar(&config.noGitignore, "no-gitignore", false, "Don't auto-apply .gitignore files found while traversing")
+	rootCmd.PersistentFlags().StringVar(&config.format, "format", "markdown", "Output format: markdown, html, json, or txt")
+	rootCmd.PersistentFlags().StringVar(&config.templatePath, "template", "", "Path to a custom text/template (or html/template for --format html) to render with")
+	rootCmd.PersistentFlags().BoolVar(&config.noMeta, "no-meta", false, "Don't detect project metadata (go.mod, package.json, Cargo.toml, pyproject.toml, git)")
+	rootCmd.PersistentFlags().BoolVar(&noMetaGit, "no-meta-git", false, "Don't include git info (branch, commit, contributors) in the detected metadata")
+	rootCmd.PersistentFlags().BoolVarP(&config.watch, "watch", "w", false, "Regenerate the output whenever the target directory changes")
+	rootCmd.PersistentFlags().IntVarP(&config.jobs, "jobs", "j", 0, "Number of files to read concurrently when including content (0 for runtime.NumCPU())")
+	for _, name := range meta.DetectorNames() {
+		disabled := false
+		metaToggles[name] = &disabled
+		rootCmd.PersistentFlags().BoolVar(&disabled, "no-meta-"+name, false, fmt.Sprintf("Don't run the %s metadata detector", name))
+	}
+
+	// Flag descriptions and examples
+	rootCmd.Flags().SetInterspersed(false)
+}
+
+func main() {
+	if err := rootCmd.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
+
+func generateReadme(config Config) error {
+	fmt.Printf("🚀 Generating README for: %s\n", config.targetDir)
+
+	// Get project name from directory
+	projectName := filepath.Base(config.targetDir)
+	if projectName == "." {
+		cwd, _ := os.Getwd()
+		projectName = filepath.Base(cwd)
+	}
+
+	// Wire the target directory into the library as an fs.FS. This is
+	// what lets pkg/tree and pkg/ignore also work against an
+	// fstest.MapFS in tests, or any other fs.FS a caller provides.
+	fsys := os.DirFS(config.targetDir)
+	matcher := ignore.NewMatcher(fsys, config.ignorePatterns, !config.noGitignore)
+	walker := tree.NewWalker(fsys, matcher, tree.Options{
+		IncludeFiles:   config.includeFiles,
+		IncludeContent: config.includeContent,
+		MaxDepth:       config.maxDepth,
+		ShowHidden:     config.showHidden,
+		IgnorePatterns: config.ignorePatterns,
+		Jobs:           config.jobs,
+	})
+
+	fmt.Printf("🌳 Building project tree...\n")
+	model, err := walker.Build(projectName, time.Now().Format("2006-01-02 15:04:05"))
+	if err != nil {
+		return fmt.Errorf("failed to build project model: %w", err)
+	}
+
+	if !config.noMeta {
+		fmt.Printf("🔍 Detecting project metadata...\n")
+		model.Meta = meta.Collect(fsys, config.targetDir, config.disabledMeta, !noMetaGit)
+	}
+
+	if err := writeAtomic(config.outputFile, func(w io.Writer) error {
+		return render.Render(w, model, config.format, config.templatePath)
+	}); err != nil {
+		return fmt.Errorf("failed to render output: %w", err)
+	}
+
+	fmt.Printf("✅ README generated successfully: %s\n", config.outputFile)
+	return nil
+}
+
+// writeAtomic runs write against a temp file in the same directory as
+// path and renames it into place on success, so a reader (an editor,
+// a previewer, `watch`'s own next run) never observes a partially
+// written file.
+func writeAtomic(path string, write func(io.Writer) error) error {
+	dir := filepath.Dir(path)
+	if dir == "" {
+		dir = "."
+	}
+
+	tmp, err := os.CreateTemp(dir, ".foldermd-*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create temp file: %w", err)
+	}
+	tmpPath := tmp.Name()
+	defer os.Remove(tmpPath) // no-op once the rename below succeeds
+
+	if err := write(tmp); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, path)
+}
+
+func createIgnoreFile() error {
+	filename := ".foldermd.ignore"
+	if _, err := os.Stat(filename); err == nil {
+		return fmt.Errorf("file %s already exists", filename)
+	}
+
+	content := `# foldermd ignore patterns
+# Lines starting with # are comments
+# Use glob patterns to match files and directories
+
+# Version control
+.git
+.svn
+.hg
+
+# Dependencies
+node_modules
+vendor
+__pycache__
+.venv
+venv
+
+# Build outputs
+build
+dist
+out
+target
+bin
+obj
+
+# IDE and editor files
+.vscode
+.idea
+*.swp
+*.swo
+*~
+
+# OS generated files
+.DS_Store
+Thumbs.db
+Desktop.ini
+
+# Logs
+*.log
+logs
+
+# Temporary files
+tmp
+temp
+*.tmp
+*.temp
+
+# Archives
+*.zip
+*.tar.gz
+*.rar
+*.7z`
+
+	err := os.WriteFile(filename, []byte(content), 0644)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", filename, err)
+	}
+
+	fmt.Printf("✅ Created %s with common ignore patterns\n", filename)
+	fmt.Printf("💡 Edit this file to customize ignore patterns for your project\n")
+	return nil
+}
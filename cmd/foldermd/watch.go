@@ -0,0 +1,191 @@
+package main
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/spf13/cobra"
+
+	"github.com/bete7512/foldermd/pkg/ignore"
+)
+
+const watchDebounce = 300 * time.Millisecond
+
+// tempOutputPrefix/tempOutputSuffix match the pattern writeAtomic
+// passes to os.CreateTemp; watch mode must ignore create/rename/remove
+// events for these or it reacts to its own atomic writes forever.
+const (
+	tempOutputPrefix = ".foldermd-"
+	tempOutputSuffix = ".tmp"
+)
+
+// isOwnTempFile reports whether name is one of the temp files
+// writeAtomic creates while regenerating the output, so watch mode
+// doesn't treat its own write as a change to react to.
+func isOwnTempFile(name string) bool {
+	base := filepath.Base(name)
+	return strings.HasPrefix(base, tempOutputPrefix) && strings.HasSuffix(base, tempOutputSuffix)
+}
+
+var watchCmd = &cobra.Command{
+	Use:   "watch [directory]",
+	Short: "Regenerate the README whenever the project changes",
+	Long: `Generate the README once, then watch the target directory and
+regenerate it every time a file changes, is created, or is removed.
+Bursts of changes are debounced so a single save doesn't trigger
+multiple regenerations.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := resolveConfig(cmd, args); err != nil {
+			return err
+		}
+		return watchAndGenerate(config)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(watchCmd)
+}
+
+// watchAndGenerate generates the README once, then rewrites it on
+// every filesystem change under config.targetDir, debounced so a burst
+// of edits triggers a single regeneration. It reuses generateReadme
+// for every run, so watch mode and a one-shot run always produce the
+// same output for the same config.
+func watchAndGenerate(config Config) error {
+	if err := generateReadme(config); err != nil {
+		return err
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to start watcher: %w", err)
+	}
+	defer watcher.Close()
+
+	fsys := os.DirFS(config.targetDir)
+	matcher := ignore.NewMatcher(fsys, config.ignorePatterns, !config.noGitignore)
+	matcher.Descend(".")
+
+	if err := installWatches(watcher, config.targetDir, matcher, config.maxDepth); err != nil {
+		return fmt.Errorf("failed to install watchers: %w", err)
+	}
+
+	fmt.Printf("👀 Watching %s for changes (Ctrl+C to stop)...\n", config.targetDir)
+
+	// Resolved once so the self-output guard below still works when
+	// -o is an absolute path (or otherwise not relative to targetDir),
+	// not just when it resolves cleanly against config.targetDir.
+	outputAbs, _ := filepath.Abs(config.outputFile)
+
+	changed := map[string]bool{}
+	timer := time.NewTimer(watchDebounce)
+	if !timer.Stop() {
+		<-timer.C
+	}
+	defer timer.Stop()
+
+	// regenerate and the debounce timer only ever run on this goroutine
+	// (the timer is drained via timer.C below, not time.AfterFunc), so
+	// changed needs no locking.
+	regenerate := func() {
+		start := time.Now()
+		n := len(changed)
+		changed = map[string]bool{}
+		if err := generateReadme(config); err != nil {
+			fmt.Printf("⚠️  regeneration failed: %v\n", err)
+			return
+		}
+		fmt.Printf("✅ regenerated %s (%d file(s) changed, %dms)\n", config.outputFile, n, time.Since(start).Milliseconds())
+	}
+
+	for {
+		select {
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+
+			if isOwnTempFile(event.Name) {
+				continue // our own atomic write, not a real change
+			}
+			if eventAbs, err := filepath.Abs(event.Name); err == nil && eventAbs == outputAbs {
+				continue // don't react to our own output, however -o was spelled
+			}
+
+			rel, err := filepath.Rel(config.targetDir, event.Name)
+			if err != nil {
+				continue
+			}
+			rel = filepath.ToSlash(rel)
+
+			info, statErr := os.Stat(event.Name)
+			isDir := statErr == nil && info.IsDir()
+			if matcher.Match(rel, isDir) {
+				continue
+			}
+
+			if event.Op&(fsnotify.Remove|fsnotify.Rename) != 0 {
+				watcher.Remove(event.Name) // tear down the watcher for a removed/renamed directory
+			}
+			if isDir && event.Op&fsnotify.Create != 0 {
+				depth := strings.Count(rel, "/") + 1
+				if config.maxDepth < 0 || depth <= config.maxDepth {
+					watcher.Add(event.Name)
+				}
+			}
+
+			changed[rel] = true
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+			timer.Reset(watchDebounce)
+
+		case <-timer.C:
+			regenerate()
+
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			fmt.Printf("⚠️  watcher error: %v\n", err)
+		}
+	}
+}
+
+// installWatches adds a watcher for dir and every non-ignored
+// subdirectory up to maxDepth, so renamed/created directories that
+// matter are covered without watching ignored trees like
+// node_modules or .git.
+func installWatches(watcher *fsnotify.Watcher, dir string, matcher *ignore.Matcher, maxDepth int) error {
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || !d.IsDir() {
+			return nil
+		}
+
+		rel, _ := filepath.Rel(dir, path)
+		rel = filepath.ToSlash(rel)
+		if rel == "." {
+			return watcher.Add(path)
+		}
+
+		depth := strings.Count(rel, "/") + 1
+		if maxDepth >= 0 && depth > maxDepth {
+			return filepath.SkipDir
+		}
+		if matcher.Match(rel, true) {
+			return filepath.SkipDir
+		}
+
+		matcher.Descend(rel)
+		return watcher.Add(path)
+	})
+}
@@ -0,0 +1,152 @@
+// Package ignore implements gitignore-compatible path matching:
+// wildmatch-style globs, patterns scoped to the directory they were
+// declared in, and last-match-wins negation.
+package ignore
+
+import (
+	"bufio"
+	"io/fs"
+	"path"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+)
+
+// pattern is a single compiled ignore rule, scoped to the directory
+// (relative to the walked root, "" meaning the root itself) where it
+// was declared.
+type pattern struct {
+	glob    string // doublestar pattern, relative to origin
+	negate  bool
+	dirOnly bool
+	origin  string
+}
+
+// Matcher matches paths against gitignore-style rules read from an
+// fs.FS, so the same logic works over a real directory (os.DirFS), an
+// in-memory tree (fstest.MapFS), or any other fs.FS implementation.
+type Matcher struct {
+	fsys         fs.FS
+	patterns     []pattern
+	useGitignore bool
+	visited      map[string]bool
+}
+
+// NewMatcher builds a Matcher over fsys, seeded with extra patterns
+// declared at the root (e.g. the --ignore flag). Nested
+// .foldermd.ignore files (and .gitignore files, unless useGitignore is
+// false) are picked up lazily via Descend as the tree is walked.
+func NewMatcher(fsys fs.FS, extra []string, useGitignore bool) *Matcher {
+	m := &Matcher{fsys: fsys, useGitignore: useGitignore, visited: map[string]bool{}}
+	for _, p := range extra {
+		if p = strings.TrimSpace(p); p != "" {
+			m.add(p, "")
+		}
+	}
+	return m
+}
+
+// Descend loads any .foldermd.ignore (and .gitignore, unless disabled)
+// found directly in dir ("." for the fsys root), scoping their
+// patterns to dir so they only ever apply to paths beneath it. Safe to
+// call more than once per dir.
+func (m *Matcher) Descend(dir string) {
+	origin := dir
+	if origin == "." {
+		origin = ""
+	}
+	if m.visited[origin] {
+		return
+	}
+	m.visited[origin] = true
+
+	m.loadFile(path.Join(dir, ".foldermd.ignore"), origin)
+	if m.useGitignore {
+		m.loadFile(path.Join(dir, ".gitignore"), origin)
+	}
+}
+
+func (m *Matcher) loadFile(p, origin string) {
+	f, err := m.fsys.Open(p)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		m.add(line, origin)
+	}
+}
+
+// add compiles a single gitignore-style line into a pattern scoped to
+// origin.
+func (m *Matcher) add(raw, origin string) {
+	p := pattern{origin: origin}
+
+	line := raw
+	if strings.HasPrefix(line, "!") {
+		p.negate = true
+		line = line[1:]
+	}
+	if strings.HasSuffix(line, "/") {
+		p.dirOnly = true
+		line = strings.TrimSuffix(line, "/")
+	}
+
+	// A pattern containing a "/" anywhere but the trailing position
+	// (including a leading "/") is anchored to its origin directory;
+	// one with no "/" may match at any depth beneath it, same as
+	// .gitignore. The separator test has to run before the leading "/"
+	// is stripped, or "/vendor" is wrongly treated as unanchored.
+	anchored := strings.Contains(line, "/")
+	line = strings.TrimPrefix(line, "/")
+	if !anchored {
+		line = "**/" + line
+	}
+
+	p.glob = line
+	m.patterns = append(m.patterns, p)
+}
+
+// Match reports whether relPath (relative to the fsys root,
+// "/"-separated) should be ignored. isDir indicates whether relPath
+// names a directory, since dirOnly patterns and a directory's own
+// patterns apply to its contents. The last matching pattern wins,
+// mirroring .gitignore precedence, so a later "!important.log" can
+// whitelist a file excluded by an earlier "*.log".
+func (m *Matcher) Match(relPath string, isDir bool) bool {
+	ignored := false
+
+	for _, p := range m.patterns {
+		target := relPath
+		if p.origin != "" {
+			prefix := p.origin + "/"
+			if !strings.HasPrefix(relPath, prefix) {
+				continue
+			}
+			target = strings.TrimPrefix(relPath, prefix)
+		}
+
+		matched := false
+		if !p.dirOnly || isDir {
+			matched, _ = doublestar.Match(p.glob, target)
+		}
+		// A directory pattern also ignores everything nested below it:
+		// every proper ancestor of target is a directory.
+		for i := 0; !matched && i < len(target); i++ {
+			if target[i] == '/' {
+				matched, _ = doublestar.Match(p.glob, target[:i])
+			}
+		}
+		if matched {
+			ignored = !p.negate
+		}
+	}
+
+	return ignored
+}
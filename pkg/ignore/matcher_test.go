@@ -0,0 +1,59 @@
+package ignore
+
+import (
+	"testing"
+	"testing/fstest"
+)
+
+func TestMatcherPatterns(t *testing.T) {
+	fsys := fstest.MapFS{
+		"file.go": {Data: []byte("package main")},
+	}
+
+	tests := []struct {
+		name    string
+		extra   []string
+		path    string
+		isDir   bool
+		ignored bool
+	}{
+		{"literal name", []string{"build"}, "build", true, true},
+		{"literal name no match", []string{"build"}, "bin", true, false},
+		{"star glob", []string{"*.log"}, "debug.log", false, true},
+		{"nested star glob", []string{"*.log"}, "logs/debug.log", false, true},
+		{"doublestar glob", []string{"**/*.min.js"}, "assets/js/app.min.js", false, true},
+		{"anchored root pattern", []string{"/vendor"}, "vendor", true, true},
+		{"anchored root pattern no match nested", []string{"/vendor"}, "pkg/vendor", true, false},
+		{"dir-only trailing slash skips files", []string{"build/"}, "build", false, false},
+		{"dir-only trailing slash matches dir", []string{"build/"}, "build", true, true},
+		{"negation whitelists a file", []string{"*.log", "!important.log"}, "important.log", false, false},
+		{"negation leaves other matches ignored", []string{"*.log", "!important.log"}, "debug.log", false, true},
+		{"directory pattern ignores nested paths", []string{"dist"}, "dist/bundle.js", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewMatcher(fsys, tt.extra, false)
+			if got := m.Match(tt.path, tt.isDir); got != tt.ignored {
+				t.Errorf("Match(%q, isDir=%v) = %v, want %v", tt.path, tt.isDir, got, tt.ignored)
+			}
+		})
+	}
+}
+
+func TestMatcherNestedOrigin(t *testing.T) {
+	fsys := fstest.MapFS{
+		"foo/.foldermd.ignore": {Data: []byte("*.tmp\n")},
+	}
+
+	m := NewMatcher(fsys, nil, false)
+	m.Descend(".")
+	m.Descend("foo")
+
+	if !m.Match("foo/scratch.tmp", false) {
+		t.Error("expected foo/scratch.tmp to be ignored by foo/.foldermd.ignore")
+	}
+	if m.Match("scratch.tmp", false) {
+		t.Error("expected root scratch.tmp to NOT be ignored by a pattern scoped to foo/")
+	}
+}
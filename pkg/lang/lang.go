@@ -0,0 +1,77 @@
+// Package lang maps file extensions to the language name used for
+// syntax highlighting in generated reports.
+package lang
+
+var byExtension = map[string]string{
+	".go":         "go",
+	".py":         "python",
+	".js":         "javascript",
+	".ts":         "typescript",
+	".jsx":        "jsx",
+	".tsx":        "tsx",
+	".java":       "java",
+	".c":          "c",
+	".cpp":        "cpp",
+	".cc":         "cpp",
+	".cxx":        "cpp",
+	".h":          "c",
+	".hpp":        "cpp",
+	".hxx":        "cpp",
+	".rs":         "rust",
+	".php":        "php",
+	".rb":         "ruby",
+	".sh":         "bash",
+	".bash":       "bash",
+	".zsh":        "zsh",
+	".fish":       "fish",
+	".ps1":        "powershell",
+	".html":       "html",
+	".htm":        "html",
+	".css":        "css",
+	".scss":       "scss",
+	".sass":       "sass",
+	".less":       "less",
+	".xml":        "xml",
+	".json":       "json",
+	".yaml":       "yaml",
+	".yml":        "yaml",
+	".toml":       "toml",
+	".ini":        "ini",
+	".cfg":        "ini",
+	".conf":       "ini",
+	".md":         "markdown",
+	".txt":        "text",
+	".sql":        "sql",
+	".r":          "r",
+	".m":          "matlab",
+	".swift":      "swift",
+	".kt":         "kotlin",
+	".kts":        "kotlin",
+	".scala":      "scala",
+	".clj":        "clojure",
+	".cljs":       "clojure",
+	".hs":         "haskell",
+	".elm":        "elm",
+	".ex":         "elixir",
+	".exs":        "elixir",
+	".erl":        "erlang",
+	".dart":       "dart",
+	".lua":        "lua",
+	".pl":         "perl",
+	".vim":        "vim",
+	".dockerfile": "dockerfile",
+	".gitignore":  "gitignore",
+	".env":        "bash",
+	".makefile":   "makefile",
+	".cmake":      "cmake",
+}
+
+// Detect returns the language name for a lowercase file extension
+// (including the leading dot, as returned by filepath.Ext), or "text"
+// if the extension isn't recognized.
+func Detect(ext string) string {
+	if l, ok := byExtension[ext]; ok {
+		return l
+	}
+	return "text"
+}
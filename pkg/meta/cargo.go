@@ -0,0 +1,44 @@
+package meta
+
+import (
+	"io/fs"
+
+	"github.com/BurntSushi/toml"
+)
+
+// cargoManifest is the subset of Cargo.toml we care about.
+type cargoManifest struct {
+	Package struct {
+		Name        string `toml:"name"`
+		Version     string `toml:"version"`
+		Description string `toml:"description"`
+	} `toml:"package"`
+}
+
+// cargoDetector reads Cargo.toml's [package] table.
+type cargoDetector struct{}
+
+func (cargoDetector) Name() string { return "cargo" }
+
+func (cargoDetector) Detect(fsys fs.FS) (*Ecosystem, error) {
+	data, err := fs.ReadFile(fsys, "Cargo.toml")
+	if err != nil {
+		return nil, nil
+	}
+
+	var m cargoManifest
+	if err := toml.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	if m.Package.Name == "" {
+		return nil, nil
+	}
+
+	return &Ecosystem{
+		Name:        "Cargo",
+		Module:      m.Package.Name,
+		Version:     m.Package.Version,
+		Description: m.Package.Description,
+		Install:     "cargo add " + m.Package.Name,
+	}, nil
+}
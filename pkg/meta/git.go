@@ -0,0 +1,52 @@
+package meta
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// GitInfo is the repository metadata shown alongside ecosystem
+// detectors when the project root is a git checkout.
+type GitInfo struct {
+	Branch           string
+	CommitHash       string
+	CommitDate       string
+	RemoteURL        string
+	ContributorCount int
+}
+
+// DetectGit inspects the real directory dir (not an fs.FS, since git
+// plumbing lives under .git and is easiest to query via the git
+// binary) and returns repository metadata, or nil if dir isn't a git
+// repository or the git binary isn't available.
+func DetectGit(dir string) *GitInfo {
+	if _, err := os.Stat(filepath.Join(dir, ".git")); err != nil {
+		return nil
+	}
+	if _, err := exec.LookPath("git"); err != nil {
+		return nil
+	}
+
+	run := func(args ...string) string {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		out, err := cmd.Output()
+		if err != nil {
+			return ""
+		}
+		return strings.TrimSpace(string(out))
+	}
+
+	info := &GitInfo{
+		Branch:     run("rev-parse", "--abbrev-ref", "HEAD"),
+		CommitHash: run("rev-parse", "--short", "HEAD"),
+		CommitDate: run("log", "-1", "--format=%ad", "--date=short"),
+		RemoteURL:  run("config", "--get", "remote.origin.url"),
+	}
+	if out := run("shortlog", "-sn", "HEAD"); out != "" {
+		info.ContributorCount = len(strings.Split(out, "\n"))
+	}
+	return info
+}
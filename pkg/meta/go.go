@@ -0,0 +1,35 @@
+package meta
+
+import (
+	"fmt"
+	"io/fs"
+
+	"golang.org/x/mod/modfile"
+)
+
+// goModDetector reads go.mod for the module path and Go version.
+type goModDetector struct{}
+
+func (goModDetector) Name() string { return "go" }
+
+func (goModDetector) Detect(fsys fs.FS) (*Ecosystem, error) {
+	data, err := fs.ReadFile(fsys, "go.mod")
+	if err != nil {
+		return nil, nil
+	}
+
+	f, err := modfile.Parse("go.mod", data, nil)
+	if err != nil {
+		return nil, fmt.Errorf("parsing go.mod: %w", err)
+	}
+
+	eco := &Ecosystem{
+		Name:    "Go",
+		Module:  f.Module.Mod.Path,
+		Install: fmt.Sprintf("go install %s@latest", f.Module.Mod.Path),
+	}
+	if f.Go != nil {
+		eco.Version = f.Go.Version
+	}
+	return eco, nil
+}
@@ -0,0 +1,83 @@
+// Package meta detects project metadata from well-known manifest
+// files (go.mod, package.json, Cargo.toml, pyproject.toml) and from
+// git, so generated reports can show an install snippet, a scripts
+// table, and repository info without the caller hand-rolling parsers.
+package meta
+
+import "io/fs"
+
+// Script is a single named command, as found in package.json's
+// "scripts" or an equivalent manifest field.
+type Script struct {
+	Name    string
+	Command string
+}
+
+// Ecosystem is what a single Detector found.
+type Ecosystem struct {
+	Name        string
+	Module      string
+	Version     string
+	Description string
+	Install     string
+	Scripts     []Script
+}
+
+// Detector inspects fsys for one ecosystem's manifest. Detect returns
+// (nil, nil) when the manifest isn't present, so Scan can skip it
+// without treating that as an error.
+type Detector interface {
+	Name() string
+	Detect(fsys fs.FS) (*Ecosystem, error)
+}
+
+var detectors = []Detector{
+	goModDetector{},
+	npmDetector{},
+	cargoDetector{},
+	pyprojectDetector{},
+}
+
+// Meta is the combined result of every detector plus, when requested,
+// git info.
+type Meta struct {
+	Ecosystems []Ecosystem
+	Git        *GitInfo
+}
+
+// Collect runs every registered detector against fsys, skipping any
+// whose Name() is listed in disabled, and attaches git info for dir
+// when includeGit is true and dir is a git repository.
+func Collect(fsys fs.FS, dir string, disabled map[string]bool, includeGit bool) *Meta {
+	m := &Meta{Ecosystems: Scan(fsys, disabled)}
+	if includeGit {
+		m.Git = DetectGit(dir)
+	}
+	return m
+}
+
+// Scan runs every registered detector against fsys, skipping any whose
+// Name() is listed in disabled.
+func Scan(fsys fs.FS, disabled map[string]bool) []Ecosystem {
+	var found []Ecosystem
+	for _, d := range detectors {
+		if disabled[d.Name()] {
+			continue
+		}
+		eco, err := d.Detect(fsys)
+		if err != nil || eco == nil {
+			continue
+		}
+		found = append(found, *eco)
+	}
+	return found
+}
+
+// DetectorNames lists the names per-detector toggles can disable.
+func DetectorNames() []string {
+	names := make([]string, len(detectors))
+	for i, d := range detectors {
+		names[i] = d.Name()
+	}
+	return names
+}
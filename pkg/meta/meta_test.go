@@ -0,0 +1,85 @@
+package meta
+
+import (
+	"testing"
+	"testing/fstest"
+)
+
+func TestScanDetectors(t *testing.T) {
+	tests := []struct {
+		name        string
+		fsys        fstest.MapFS
+		wantName    string
+		wantModule  string
+		wantVersion string
+	}{
+		{
+			name: "go.mod",
+			fsys: fstest.MapFS{
+				"go.mod": {Data: []byte("module github.com/example/proj\n\ngo 1.22\n")},
+			},
+			wantName:    "Go",
+			wantModule:  "github.com/example/proj",
+			wantVersion: "1.22",
+		},
+		{
+			name: "package.json",
+			fsys: fstest.MapFS{
+				"package.json": {Data: []byte(`{"name":"widget","version":"1.0.0"}`)},
+			},
+			wantName:    "npm",
+			wantModule:  "widget",
+			wantVersion: "1.0.0",
+		},
+		{
+			name: "Cargo.toml",
+			fsys: fstest.MapFS{
+				"Cargo.toml": {Data: []byte("[package]\nname = \"widget\"\nversion = \"0.1.0\"\n")},
+			},
+			wantName:    "Cargo",
+			wantModule:  "widget",
+			wantVersion: "0.1.0",
+		},
+		{
+			name: "pyproject.toml project table",
+			fsys: fstest.MapFS{
+				"pyproject.toml": {Data: []byte("[project]\nname = \"widget\"\nversion = \"2.0.0\"\n")},
+			},
+			wantName:    "Python",
+			wantModule:  "widget",
+			wantVersion: "2.0.0",
+		},
+		{
+			name: "pyproject.toml poetry table",
+			fsys: fstest.MapFS{
+				"pyproject.toml": {Data: []byte("[tool.poetry]\nname = \"widget\"\nversion = \"3.0.0\"\n")},
+			},
+			wantName:    "Python",
+			wantModule:  "widget",
+			wantVersion: "3.0.0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			found := Scan(tt.fsys, nil)
+			if len(found) != 1 {
+				t.Fatalf("got %d ecosystems, want 1: %+v", len(found), found)
+			}
+			if found[0].Name != tt.wantName || found[0].Module != tt.wantModule || found[0].Version != tt.wantVersion {
+				t.Errorf("got %+v, want Name=%q Module=%q Version=%q", found[0], tt.wantName, tt.wantModule, tt.wantVersion)
+			}
+		})
+	}
+}
+
+func TestScanDisabled(t *testing.T) {
+	fsys := fstest.MapFS{
+		"go.mod": {Data: []byte("module example.com/x\n\ngo 1.22\n")},
+	}
+
+	found := Scan(fsys, map[string]bool{"go": true})
+	if len(found) != 0 {
+		t.Errorf("expected disabled detector to be skipped, got %+v", found)
+	}
+}
@@ -0,0 +1,52 @@
+package meta
+
+import (
+	"encoding/json"
+	"io/fs"
+	"sort"
+)
+
+// npmManifest is the subset of package.json we care about.
+type npmManifest struct {
+	Name        string            `json:"name"`
+	Version     string            `json:"version"`
+	Description string            `json:"description"`
+	Scripts     map[string]string `json:"scripts"`
+}
+
+// npmDetector reads package.json for name, version, description and
+// scripts.
+type npmDetector struct{}
+
+func (npmDetector) Name() string { return "npm" }
+
+func (npmDetector) Detect(fsys fs.FS) (*Ecosystem, error) {
+	data, err := fs.ReadFile(fsys, "package.json")
+	if err != nil {
+		return nil, nil
+	}
+
+	var m npmManifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+
+	eco := &Ecosystem{
+		Name:        "npm",
+		Module:      m.Name,
+		Version:     m.Version,
+		Description: m.Description,
+		Install:     "npm install",
+	}
+
+	names := make([]string, 0, len(m.Scripts))
+	for name := range m.Scripts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		eco.Scripts = append(eco.Scripts, Script{Name: name, Command: m.Scripts[name]})
+	}
+
+	return eco, nil
+}
@@ -0,0 +1,57 @@
+package meta
+
+import (
+	"io/fs"
+
+	"github.com/BurntSushi/toml"
+)
+
+// pyprojectManifest covers both PEP 621's [project] table and the
+// older [tool.poetry] table, since both are common in the wild.
+type pyprojectManifest struct {
+	Project struct {
+		Name        string `toml:"name"`
+		Version     string `toml:"version"`
+		Description string `toml:"description"`
+	} `toml:"project"`
+	Tool struct {
+		Poetry struct {
+			Name        string `toml:"name"`
+			Version     string `toml:"version"`
+			Description string `toml:"description"`
+		} `toml:"poetry"`
+	} `toml:"tool"`
+}
+
+// pyprojectDetector reads pyproject.toml for package metadata.
+type pyprojectDetector struct{}
+
+func (pyprojectDetector) Name() string { return "python" }
+
+func (pyprojectDetector) Detect(fsys fs.FS) (*Ecosystem, error) {
+	data, err := fs.ReadFile(fsys, "pyproject.toml")
+	if err != nil {
+		return nil, nil
+	}
+
+	var m pyprojectManifest
+	if err := toml.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+
+	name, version, description := m.Project.Name, m.Project.Version, m.Project.Description
+	if name == "" {
+		name, version, description = m.Tool.Poetry.Name, m.Tool.Poetry.Version, m.Tool.Poetry.Description
+	}
+	if name == "" {
+		return nil, nil
+	}
+
+	return &Ecosystem{
+		Name:        "Python",
+		Module:      name,
+		Version:     version,
+		Description: description,
+		Install:     "pip install " + name,
+	}, nil
+}
@@ -0,0 +1,162 @@
+// Package render turns a tree.Model into a report. The default
+// formats are driven by embedded text/template (or html/template)
+// files; callers can supply their own template to build custom
+// formats without recompiling foldermd.
+package render
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"path/filepath"
+	"strings"
+	textTemplate "text/template"
+
+	"github.com/bete7512/foldermd/pkg/lang"
+	"github.com/bete7512/foldermd/pkg/tree"
+)
+
+//go:embed templates/*.tmpl
+var defaultTemplatesFS embed.FS
+
+// defaultTemplateFor maps --format to the embedded template that
+// reproduces today's output for that format.
+var defaultTemplateFor = map[string]string{
+	"markdown": "templates/default.md.tmpl",
+	"txt":      "templates/default.txt.tmpl",
+	"html":     "templates/default.html.tmpl",
+}
+
+// Formats lists the built-in format names, for flag help/validation.
+func Formats() []string {
+	return []string{"markdown", "html", "json", "txt"}
+}
+
+// IsFormat reports whether format is one Render knows how to produce.
+func IsFormat(format string) bool {
+	if format == "json" {
+		return true
+	}
+	_, ok := defaultTemplateFor[format]
+	return ok
+}
+
+// TreeEntry is one line of the rendered tree: the child node plus the
+// prefix/connector a template needs to draw it, and the prefix its own
+// children should use, so templates can recurse without reimplementing
+// box-drawing bookkeeping.
+type TreeEntry struct {
+	Node        *tree.Node
+	Prefix      string
+	Connector   string
+	ChildPrefix string
+}
+
+// TreeArgs bundles a node's children, their shared prefix, and the
+// IncludeContent flag (needed to decide whether entries get emoji
+// markers) so the recursive "tree" template block can be invoked with
+// a single value.
+type TreeArgs struct {
+	Children       []*tree.Node
+	Prefix         string
+	IncludeContent bool
+}
+
+// templateFuncs are exposed to every template (default or user-supplied)
+// so custom formats can be built without recompiling foldermd.
+var templateFuncs = map[string]interface{}{
+	"sizeHuman":     formatFileSize,
+	"lang":          func(name string) string { return lang.Detect(strings.ToLower(filepath.Ext(name))) },
+	"indent":        indentFor,
+	"treeConnector": treeConnector,
+	"treeArgs": func(children []*tree.Node, prefix string, includeContent bool) TreeArgs {
+		return TreeArgs{children, prefix, includeContent}
+	},
+	"treeEntries": treeEntries,
+	"join":        func(ss []string) string { return strings.Join(ss, ", ") },
+}
+
+func treeConnector(isLast bool) string {
+	if isLast {
+		return "└── "
+	}
+	return "├── "
+}
+
+func indentFor(isLast bool) string {
+	if isLast {
+		return "    "
+	}
+	return "│   "
+}
+
+func treeEntries(children []*tree.Node, prefix string) []TreeEntry {
+	entries := make([]TreeEntry, len(children))
+	for i, child := range children {
+		isLast := i == len(children)-1
+		entries[i] = TreeEntry{
+			Node:        child,
+			Prefix:      prefix,
+			Connector:   treeConnector(isLast),
+			ChildPrefix: prefix + indentFor(isLast),
+		}
+	}
+	return entries
+}
+
+func formatFileSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(size)/float64(div), "KMGTPE"[exp])
+}
+
+// Render writes model to w using the requested format and, if set, a
+// user-supplied template file. json bypasses templating entirely since
+// the model already is the desired output.
+func Render(w io.Writer, model *tree.Model, format, templatePath string) error {
+	if format == "json" {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(model)
+	}
+
+	name := defaultTemplateFor[format]
+	if name == "" {
+		return fmt.Errorf("unknown format %q", format)
+	}
+
+	if format == "html" {
+		var tmpl *template.Template
+		var err error
+		if templatePath != "" {
+			tmpl, err = template.New(filepath.Base(templatePath)).Funcs(templateFuncs).ParseFiles(templatePath)
+		} else {
+			tmpl, err = template.New(filepath.Base(name)).Funcs(templateFuncs).ParseFS(defaultTemplatesFS, name)
+		}
+		if err != nil {
+			return fmt.Errorf("parsing template: %w", err)
+		}
+		return tmpl.Execute(w, model)
+	}
+
+	var tmpl *textTemplate.Template
+	var err error
+	if templatePath != "" {
+		tmpl, err = textTemplate.New(filepath.Base(templatePath)).Funcs(templateFuncs).ParseFiles(templatePath)
+	} else {
+		tmpl, err = textTemplate.New(filepath.Base(name)).Funcs(templateFuncs).ParseFS(defaultTemplatesFS, name)
+	}
+	if err != nil {
+		return fmt.Errorf("parsing template: %w", err)
+	}
+	return tmpl.Execute(w, model)
+}
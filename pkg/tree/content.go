@@ -0,0 +1,117 @@
+package tree
+
+import (
+	"bytes"
+	"io"
+	"io/fs"
+	"runtime"
+	"sync"
+)
+
+// maxContentSize is the largest file foldermd will read into memory for
+// a report; anything bigger is flagged TooLarge instead.
+const maxContentSize = 1024 * 1024
+
+// fileResult is what a worker produces for one file; populateContents
+// applies it back onto the matching Node once read.
+type fileResult struct {
+	node     *Node
+	binary   bool
+	tooLarge bool
+	content  string
+}
+
+// populateContents reads every file in files and fills in its Content,
+// Binary and TooLarge fields. Reads are spread across a bounded pool of
+// workers (Options.Jobs, default runtime.NumCPU()) since, on a large
+// tree, opening and reading files one at a time is almost entirely I/O
+// wait. Workers only ever read their own file; the fields of a given
+// Node are only ever written by the goroutine that applies its
+// fileResult, so nothing here needs a lock.
+func (w *Walker) populateContents(files []*Node) {
+	if len(files) == 0 {
+		return
+	}
+
+	jobs := w.Options.Jobs
+	if jobs <= 0 {
+		jobs = runtime.NumCPU()
+	}
+	if jobs > len(files) {
+		jobs = len(files)
+	}
+
+	work := make(chan *Node)
+	results := make(chan fileResult)
+
+	var wg sync.WaitGroup
+	wg.Add(jobs)
+	for i := 0; i < jobs; i++ {
+		go func() {
+			defer wg.Done()
+			for node := range work {
+				results <- readFile(w.FS, node)
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	go func() {
+		for _, node := range files {
+			work <- node
+		}
+		close(work)
+	}()
+
+	for result := range results {
+		result.node.Binary = result.binary
+		result.node.TooLarge = result.tooLarge
+		result.node.Content = result.content
+	}
+}
+
+// readFile opens node's file exactly once, sniffing the first 512
+// bytes for a null byte to classify it as binary and, if it's text and
+// not over maxContentSize, reading the rest of it as the node's
+// content.
+func readFile(fsys fs.FS, node *Node) fileResult {
+	result := fileResult{node: node}
+
+	if node.Size > maxContentSize {
+		result.tooLarge = true
+		return result
+	}
+
+	f, err := fsys.Open(node.Path)
+	if err != nil {
+		result.binary = true
+		return result
+	}
+	defer f.Close()
+
+	var buf bytes.Buffer
+	sniff := make([]byte, 512)
+	n, err := f.Read(sniff)
+	if err != nil && err != io.EOF {
+		result.binary = true
+		return result
+	}
+	sniff = sniff[:n]
+	if bytes.IndexByte(sniff, 0) != -1 {
+		result.binary = true
+		return result
+	}
+	buf.Write(sniff)
+
+	if _, err := io.Copy(&buf, f); err != nil {
+		result.binary = true
+		return result
+	}
+
+	result.content = buf.String()
+	return result
+}
@@ -0,0 +1,50 @@
+package tree
+
+import (
+	"fmt"
+	"testing"
+	"testing/fstest"
+
+	"github.com/bete7512/foldermd/pkg/ignore"
+)
+
+// bigFS builds a synthetic tree of n small text files spread across a
+// handful of directories, to approximate a large real-world repo for
+// benchmarking the content-population pass.
+func bigFS(n int) fstest.MapFS {
+	fsys := fstest.MapFS{}
+	for i := 0; i < n; i++ {
+		dir := fmt.Sprintf("pkg%d", i%50)
+		fsys[fmt.Sprintf("%s/file%d.go", dir, i)] = &fstest.MapFile{
+			Data: []byte(fmt.Sprintf("package pkg%d\n\nvar x = %d\n", i%50, i)),
+		}
+	}
+	return fsys
+}
+
+func benchmarkPopulateContents(b *testing.B, jobs int) {
+	fsys := bigFS(10000)
+	opts := Options{IncludeFiles: true, IncludeContent: true, MaxDepth: -1, Jobs: jobs}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		w := NewWalker(fsys, ignore.NewMatcher(fsys, nil, false), opts)
+		if _, err := w.Build("bench", "now"); err != nil {
+			b.Fatalf("Build: %v", err)
+		}
+	}
+}
+
+// BenchmarkPopulateContentsSequential pins Jobs to 1, giving a baseline
+// to compare the pooled reads in BenchmarkPopulateContentsParallel
+// against on a 10k-file tree.
+func BenchmarkPopulateContentsSequential(b *testing.B) {
+	benchmarkPopulateContents(b, 1)
+}
+
+// BenchmarkPopulateContentsParallel uses the default worker count
+// (runtime.NumCPU()) and should comfortably beat the sequential
+// baseline above on any multi-core machine.
+func BenchmarkPopulateContentsParallel(b *testing.B) {
+	benchmarkPopulateContents(b, 0)
+}
@@ -0,0 +1,245 @@
+// Package tree walks an fs.FS into a structured Model: a Node tree
+// plus summary counts, ready for a renderer to turn into a report
+// without touching the filesystem again.
+package tree
+
+import (
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/bete7512/foldermd/pkg/ignore"
+	"github.com/bete7512/foldermd/pkg/lang"
+	"github.com/bete7512/foldermd/pkg/meta"
+)
+
+// Node is one entry in the project tree: a directory or a file, with
+// enough metadata for any renderer to describe it without re-walking
+// the filesystem.
+type Node struct {
+	Name     string  `json:"name"`
+	Path     string  `json:"path"` // relative to the walked root, "/"-separated
+	IsDir    bool    `json:"isDir"`
+	Size     int64   `json:"size,omitempty"`
+	Language string  `json:"language,omitempty"`
+	Content  string  `json:"content,omitempty"`
+	Binary   bool    `json:"binary,omitempty"`
+	TooLarge bool    `json:"tooLarge,omitempty"`
+	Children []*Node `json:"children,omitempty"`
+}
+
+// Overview holds the summary counts shown near the top of a report.
+type Overview struct {
+	FileCount int    `json:"fileCount"`
+	DirCount  int    `json:"dirCount"`
+	Root      string `json:"root"`
+}
+
+// Model is the structured, renderer-agnostic result of a walk.
+type Model struct {
+	ProjectName    string     `json:"projectName"`
+	GeneratedAt    string     `json:"generatedAt"`
+	Overview       Overview   `json:"overview"`
+	Root           *Node      `json:"root"`
+	Files          []*Node    `json:"files"`
+	IncludeFiles   bool       `json:"includeFiles"`
+	IncludeContent bool       `json:"includeContent"`
+	MaxDepth       int        `json:"maxDepth"`
+	ShowHidden     bool       `json:"showHidden"`
+	IgnorePatterns []string   `json:"ignorePatterns"`
+	Meta           *meta.Meta `json:"meta,omitempty"`
+}
+
+// Options controls which entries a Walker includes and how deep it
+// descends. It's the library-facing subset of the CLI's flags.
+type Options struct {
+	IncludeFiles   bool
+	IncludeContent bool
+	MaxDepth       int
+	ShowHidden     bool
+	IgnorePatterns []string
+	// Jobs bounds how many files are read concurrently when
+	// IncludeContent is set. <= 0 means runtime.NumCPU().
+	Jobs int
+}
+
+// Walker builds a Model from an fs.FS. Callers can pass os.DirFS for a
+// real directory, an fstest.MapFS for tests, or any other fs.FS (an
+// archive/zip reader, a filtered overlay, etc).
+type Walker struct {
+	FS      fs.FS
+	Matcher *ignore.Matcher
+	Options Options
+}
+
+// NewWalker returns a Walker over fsys. If matcher is nil, a Matcher
+// seeded with Options.IgnorePatterns and default .gitignore handling
+// is created.
+func NewWalker(fsys fs.FS, matcher *ignore.Matcher, opts Options) *Walker {
+	if matcher == nil {
+		matcher = ignore.NewMatcher(fsys, opts.IgnorePatterns, true)
+	}
+	return &Walker{FS: fsys, Matcher: matcher, Options: opts}
+}
+
+// Build walks the tree rooted at "." in w.FS and returns the Model a
+// renderer consumes.
+func (w *Walker) Build(projectName, generatedAt string) (*Model, error) {
+	w.Matcher.Descend(".")
+
+	root, files, err := w.buildNode(".", "", 0)
+	if err != nil {
+		return nil, err
+	}
+
+	fileCount, dirCount := w.countAll()
+
+	model := &Model{
+		ProjectName: projectName,
+		GeneratedAt: generatedAt,
+		Overview: Overview{
+			FileCount: fileCount,
+			DirCount:  dirCount - 1, // subtract the root directory itself
+			Root:      ".",
+		},
+		Root:           root,
+		IncludeFiles:   w.Options.IncludeFiles,
+		IncludeContent: w.Options.IncludeContent,
+		MaxDepth:       w.Options.MaxDepth,
+		ShowHidden:     w.Options.ShowHidden,
+		IgnorePatterns: w.Options.IgnorePatterns,
+	}
+	model.Files = files
+
+	if w.Options.IncludeContent {
+		w.populateContents(model.Files)
+	}
+
+	return model, nil
+}
+
+// buildNode builds the filtered, depth-limited Node tree for dir
+// ("." for the root), mirroring the original tree writer's traversal
+// rules: ignored and (unless ShowHidden) dot entries are skipped, and
+// a directory beyond MaxDepth is still listed but left childless. The
+// walk itself stays sequential — directory reads are cheap and
+// order-dependent (sorting, depth tracking); only the expensive part,
+// reading file content, is pooled, in populateContents.
+//
+// Alongside the Node, buildNode returns every file beneath dir in
+// fs.ReadDir's natural lexical order — the same interleaving of files
+// and subdirectories filepath.WalkDir visits them in — for the "File
+// Contents" section. That's independent of node.Children, which is
+// sorted directories-first for the "Project Structure" tree view.
+func (w *Walker) buildNode(dir, relDir string, depth int) (*Node, []*Node, error) {
+	w.Matcher.Descend(dir)
+
+	name := path.Base(dir)
+	if dir == "." {
+		name = "."
+	}
+	node := &Node{Name: name, Path: relDir, IsDir: true}
+	if w.Options.MaxDepth >= 0 && depth > w.Options.MaxDepth {
+		return node, nil, nil
+	}
+
+	entries, err := fs.ReadDir(w.FS, dir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var filtered []fs.DirEntry
+	for _, entry := range entries {
+		entryRel := entry.Name()
+		if relDir != "" {
+			entryRel = relDir + "/" + entry.Name()
+		}
+		if w.Matcher.Match(entryRel, entry.IsDir()) {
+			continue
+		}
+		if !w.Options.ShowHidden && strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		if !w.Options.IncludeFiles && !entry.IsDir() {
+			continue
+		}
+		filtered = append(filtered, entry)
+	}
+
+	// fs.ReadDir already returns entries sorted lexically by name; keep
+	// that order for the file-contents listing before re-sorting
+	// (directories-first) for the tree view below.
+	lexical := make([]fs.DirEntry, len(filtered))
+	copy(lexical, filtered)
+
+	sort.Slice(filtered, func(i, j int) bool {
+		if filtered[i].IsDir() != filtered[j].IsDir() {
+			return filtered[i].IsDir()
+		}
+		return filtered[i].Name() < filtered[j].Name()
+	})
+
+	childByName := map[string]*Node{}
+	filesByName := map[string][]*Node{}
+
+	for _, entry := range filtered {
+		childRel := entry.Name()
+		if relDir != "" {
+			childRel = relDir + "/" + entry.Name()
+		}
+		childDir := entry.Name()
+		if dir != "." {
+			childDir = path.Join(dir, entry.Name())
+		}
+
+		if entry.IsDir() {
+			child, childFiles, err := w.buildNode(childDir, childRel, depth+1)
+			if err != nil {
+				return nil, nil, err
+			}
+			node.Children = append(node.Children, child)
+			childByName[entry.Name()] = child
+			filesByName[entry.Name()] = childFiles
+			continue
+		}
+
+		child := &Node{Name: entry.Name(), Path: childRel}
+		child.Language = lang.Detect(strings.ToLower(path.Ext(entry.Name())))
+		if info, err := entry.Info(); err == nil {
+			child.Size = info.Size()
+		}
+
+		node.Children = append(node.Children, child)
+		childByName[entry.Name()] = child
+	}
+
+	var files []*Node
+	for _, entry := range lexical {
+		if entry.IsDir() {
+			files = append(files, filesByName[entry.Name()]...)
+		} else {
+			files = append(files, childByName[entry.Name()])
+		}
+	}
+
+	return node, files, nil
+}
+
+// countAll counts every file and directory under the fsys root,
+// regardless of ignore patterns or ShowHidden, matching the overview
+// counts foldermd has always shown.
+func (w *Walker) countAll() (fileCount, dirCount int) {
+	fs.WalkDir(w.FS, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		if d.IsDir() {
+			dirCount++
+		} else {
+			fileCount++
+		}
+		return nil
+	})
+	return fileCount, dirCount
+}
@@ -0,0 +1,86 @@
+package tree
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/bete7512/foldermd/pkg/ignore"
+)
+
+func newWalker(fsys fstest.MapFS, opts Options) *Walker {
+	return NewWalker(fsys, ignore.NewMatcher(fsys, opts.IgnorePatterns, false), opts)
+}
+
+func TestWalkerOrdering(t *testing.T) {
+	fsys := fstest.MapFS{
+		"zebra.go":     {Data: []byte("package main")},
+		"apple.go":     {Data: []byte("package main")},
+		"bananas/a.go": {Data: []byte("package main")},
+	}
+
+	w := newWalker(fsys, Options{IncludeFiles: true, MaxDepth: -1})
+	model, err := w.Build("proj", "now")
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+
+	children := model.Root.Children
+	if len(children) != 3 {
+		t.Fatalf("got %d children, want 3", len(children))
+	}
+	// Directories sort before files; within each group, alphabetically.
+	want := []string{"bananas", "apple.go", "zebra.go"}
+	for i, name := range want {
+		if children[i].Name != name {
+			t.Errorf("children[%d] = %q, want %q", i, children[i].Name, name)
+		}
+	}
+}
+
+func TestWalkerDepthLimit(t *testing.T) {
+	fsys := fstest.MapFS{
+		"a/b/c.go": {Data: []byte("package main")},
+	}
+
+	w := newWalker(fsys, Options{IncludeFiles: true, MaxDepth: 0})
+	model, err := w.Build("proj", "now")
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+
+	a := model.Root.Children[0]
+	if a.Name != "a" {
+		t.Fatalf("expected top-level dir %q, got %q", "a", a.Name)
+	}
+	if len(a.Children) != 0 {
+		t.Errorf("expected depth-limited dir to have no children, got %d", len(a.Children))
+	}
+}
+
+func TestWalkerBinaryDetection(t *testing.T) {
+	fsys := fstest.MapFS{
+		"text.go":   {Data: []byte("package main\n")},
+		"image.png": {Data: []byte("\x89PNG\x00\x01\x02")},
+	}
+
+	w := newWalker(fsys, Options{IncludeFiles: true, IncludeContent: true, MaxDepth: -1})
+	model, err := w.Build("proj", "now")
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+
+	byName := map[string]*Node{}
+	for _, f := range model.Files {
+		byName[f.Name] = f
+	}
+
+	if byName["text.go"].Binary {
+		t.Error("text.go should not be detected as binary")
+	}
+	if byName["text.go"].Content == "" {
+		t.Error("text.go should have its content populated")
+	}
+	if !byName["image.png"].Binary {
+		t.Error("image.png should be detected as binary")
+	}
+}